@@ -0,0 +1,183 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package artifacts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"go.uber.org/zap"
+)
+
+// extensionManifestArtifactType is the OCI 1.1 Referrers artifactType used by Talos releases that
+// publish their extension manifest as a referrer of the imager image, rather than bundling it as
+// an `image-digests` file inside ExtensionManifestImage.
+const extensionManifestArtifactType = "application/vnd.siderolabs.extension.manifest.v1+json"
+
+// errNoExtensions is returned by an extensionSource when it has nothing to offer for a tag, so
+// fetchOfficialExtensions can fall through to the next source instead of treating it as fatal.
+var errNoExtensions = errors.New("extension source produced no extensions")
+
+// extensionSource discovers the official extensions available for a given Talos release tag.
+// fetchOfficialExtensions tries each registered source in order.
+type extensionSource interface {
+	name() string
+	fetch(ctx context.Context, m *Manager, tag string) ([]ExtensionRef, error)
+}
+
+// extensionSources returns the extensionSource chain, tried in order by fetchOfficialExtensions.
+func (m *Manager) extensionSources() []extensionSource {
+	return []extensionSource{tarManifestSource{}, referrersSource{}}
+}
+
+// tarManifestSource extracts the `image-digests` file bundled inside ExtensionManifestImage.
+type tarManifestSource struct{}
+
+func (tarManifestSource) name() string { return "tar-manifest" }
+
+func (tarManifestSource) fetch(_ context.Context, m *Manager, tag string) ([]ExtensionRef, error) {
+	var extensions []ExtensionRef
+
+	if err := m.fetchImageByTag(ExtensionManifestImage, tag, ArchAmd64, imageExportHandler(func(logger *zap.Logger, r io.Reader) error {
+		var extractErr error
+
+		extensions, extractErr = extractExtensionList(r)
+
+		if extractErr == nil {
+			logger.Info("extracted the image digests", zap.Int("count", len(extensions)))
+		}
+
+		return extractErr
+	})); err != nil {
+		if isNotFoundErr(err) || errors.Is(err, errImageDigestsNotFound) {
+			return nil, errNoExtensions
+		}
+
+		return nil, err
+	}
+
+	if len(extensions) == 0 {
+		return nil, errNoExtensions
+	}
+
+	return extensions, nil
+}
+
+// referrersSource discovers extensions via the OCI 1.1 Referrers API against the imager image digest.
+type referrersSource struct{}
+
+func (referrersSource) name() string { return "oci-referrers" }
+
+func (referrersSource) fetch(ctx context.Context, m *Manager, tag string) ([]ExtensionRef, error) {
+	repository := m.imageRegistry.Repo(ImagerImage)
+
+	digest, _, err := m.pullers[ArchAmd64].Head(ctx, repository, tag)
+	if err != nil {
+		if isNotFoundErr(err) {
+			return nil, errNoExtensions
+		}
+
+		return nil, fmt.Errorf("failed to resolve imager image digest: %w", err)
+	}
+
+	referrers, err := m.pullers[ArchAmd64].Referrers(ctx, repository, digest, extensionManifestArtifactType)
+	if err != nil {
+		if isNotFoundErr(err) {
+			return nil, errNoExtensions
+		}
+
+		return nil, fmt.Errorf("failed to query referrers for %s: %w", digest, err)
+	}
+
+	var extensions []ExtensionRef
+
+	for _, referrer := range referrers {
+		refs, err := fetchExtensionManifestDescriptor(ctx, m, repository, referrer.Digest.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch extension manifest descriptor %s: %w", referrer.Digest, err)
+		}
+
+		extensions = append(extensions, refs...)
+	}
+
+	if len(extensions) == 0 {
+		return nil, errNoExtensions
+	}
+
+	return extensions, nil
+}
+
+// fetchExtensionManifestDescriptor fetches the referrer manifest at digest - a manifest descriptor,
+// not a blob, so it must be read through the manifests endpoint - then reads and decodes its sole
+// layer blob into extension references.
+func fetchExtensionManifestDescriptor(ctx context.Context, m *Manager, repository name.Repository, digest string) ([]ExtensionRef, error) {
+	manifest, err := m.pullers[ArchAmd64].Manifest(ctx, repository, digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch referrer manifest: %w", err)
+	}
+
+	layers, err := manifest.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read referrer manifest layers: %w", err)
+	}
+
+	if len(layers) == 0 {
+		return nil, errors.New("referrer manifest has no layers")
+	}
+
+	rc, err := layers[0].Uncompressed()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read referrer blob: %w", err)
+	}
+	defer rc.Close() //nolint:errcheck
+
+	return decodeExtensionManifestJSON(rc)
+}
+
+// decodeExtensionManifestJSON decodes a referrer blob's JSON descriptor list into extension refs.
+func decodeExtensionManifestJSON(r io.Reader) ([]ExtensionRef, error) {
+	var descriptors []struct {
+		Image  string `json:"image"`
+		Digest string `json:"digest"`
+	}
+
+	if err := json.NewDecoder(r).Decode(&descriptors); err != nil {
+		return nil, fmt.Errorf("failed to decode extension manifest JSON: %w", err)
+	}
+
+	extensions := make([]ExtensionRef, 0, len(descriptors))
+
+	for _, d := range descriptors {
+		taggedRef, err := name.NewTag(d.Image)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse extension image %s: %w", d.Image, err)
+		}
+
+		extensions = append(extensions, ExtensionRef{
+			TaggedReference: taggedRef,
+			Digest:          d.Digest,
+		})
+	}
+
+	return extensions, nil
+}
+
+// isNotFoundErr reports whether err is a registry 404, indicating the manifest image itself isn't
+// present for this tag.
+func isNotFoundErr(err error) bool {
+	var terr *transport.Error
+
+	if errors.As(err, &terr) {
+		return terr.StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
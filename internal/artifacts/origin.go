@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package artifacts
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// OriginMetadata records where a cached piece of artifact data came from and how fresh it is.
+type OriginMetadata struct {
+	Registry  string
+	Ref       string
+	Digest    string
+	ETag      string
+	Source    string
+	FetchedAt time.Time
+}
+
+// origin returns the recorded origin metadata for key, if any.
+func (m *Manager) origin(key string) (OriginMetadata, bool) {
+	m.originMu.RLock()
+	defer m.originMu.RUnlock()
+
+	meta, ok := m.origins[key]
+
+	return meta, ok
+}
+
+// recordOrigin stores the origin metadata for key, overwriting any previous entry.
+func (m *Manager) recordOrigin(key string, meta OriginMetadata) {
+	m.originMu.Lock()
+	defer m.originMu.Unlock()
+
+	if m.origins == nil {
+		m.origins = make(map[string]OriginMetadata)
+	}
+
+	m.origins[key] = meta
+}
+
+// Origins returns a snapshot of the recorded origin metadata, keyed the same way
+// fetchTalosVersions and fetchOfficialExtensions record it.
+func (m *Manager) Origins() map[string]OriginMetadata {
+	m.originMu.RLock()
+	defer m.originMu.RUnlock()
+
+	origins := make(map[string]OriginMetadata, len(m.origins))
+
+	for key, meta := range m.origins {
+		origins[key] = meta
+	}
+
+	return origins
+}
+
+// hashTagSet returns a stable digest of an already-sorted tag set, so two listings differing only
+// in order compare equal.
+func hashTagSet(sortedTags []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(sortedTags, "\n")))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,163 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package artifacts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/google/go-containerregistry/pkg/name"
+)
+
+// Severity buckets how far behind an extension reference is from the latest known tag.
+type Severity string
+
+// Severity values reported by OutdatedExtensions.
+const (
+	SeverityUpToDate    Severity = "up-to-date"
+	SeverityMinorBehind Severity = "minor-behind"
+	SeverityMajorBehind Severity = "major-behind"
+	SeverityUnknown     Severity = "unknown"
+)
+
+// OutdatedEntry reports the outdated status of a single extension reference baked into a
+// schematic, relative to the latest known official extension set.
+type OutdatedEntry struct {
+	Extension string
+	Current   string
+	Latest    string
+	Severity  Severity
+}
+
+// OutdatedExtensions compares the extensions baked into schematicID against the official
+// extension set recorded for the most recent known Talos version, bucketing each extension by
+// how far behind it is.
+func (m *Manager) OutdatedExtensions(schematicID string) ([]OutdatedEntry, error) {
+	sch, err := m.schematicStore.Get(schematicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load schematic %s: %w", schematicID, err)
+	}
+
+	latest := m.latestOfficialExtensions()
+
+	refs := sch.Customization.SystemExtensions.OfficialExtensions
+
+	entries := make([]OutdatedEntry, 0, len(refs))
+
+	for _, ref := range refs {
+		extName, currentTag, err := splitExtensionRef(ref)
+		if err != nil {
+			entries = append(entries, OutdatedEntry{Extension: ref, Severity: SeverityUnknown})
+
+			continue
+		}
+
+		latestRef, ok := latest[extName]
+		if !ok {
+			entries = append(entries, OutdatedEntry{Extension: extName, Current: currentTag, Severity: SeverityUnknown})
+
+			continue
+		}
+
+		latestTag := latestRef.TaggedReference.TagStr()
+
+		entries = append(entries, OutdatedEntry{
+			Extension: extName,
+			Current:   currentTag,
+			Latest:    latestTag,
+			Severity:  severityFor(currentTag, latestTag),
+		})
+	}
+
+	return entries, nil
+}
+
+// latestOfficialExtensions returns the official extension set for the highest-versioned fetched Talos
+// tag, keyed by extension name (registry/repo prefix stripped). The latest tag is determined from
+// the keys of m.officialExtensions itself - the same original registry tag strings
+// fetchOfficialExtensions indexes the map by - rather than re-derived from m.talosVersions, whose
+// semver.Version.String() form (e.g. "1.7.0-alpha.0") does not match the registry tag it came from
+// (e.g. "v1.7.0-alpha.0").
+func (m *Manager) latestOfficialExtensions() map[string]ExtensionRef {
+	m.officialExtensionsMu.Lock()
+
+	var (
+		latestTag     string
+		latestVersion semver.Version
+		haveLatest    bool
+	)
+
+	for tag := range m.officialExtensions {
+		version, err := semver.ParseTolerant(tag)
+		if err != nil {
+			continue
+		}
+
+		if !haveLatest || version.GT(latestVersion) {
+			latestVersion, latestTag, haveLatest = version, tag, true
+		}
+	}
+
+	extensions := m.officialExtensions[latestTag]
+
+	m.officialExtensionsMu.Unlock()
+
+	byName := make(map[string]ExtensionRef, len(extensions))
+
+	for _, ext := range extensions {
+		byName[extensionName(ext.TaggedReference)] = ext
+	}
+
+	return byName
+}
+
+// severityFor buckets how far the current tag is behind the latest tag when both parse as semver.
+// A current tag that is not strictly older than latest (equal, or genuinely ahead - e.g. a stale
+// cache read during a refresh) is reported as up-to-date rather than behind.
+func severityFor(current, latest string) Severity {
+	if current == latest {
+		return SeverityUpToDate
+	}
+
+	currentVersion, err := semver.ParseTolerant(current)
+	if err != nil {
+		return SeverityUnknown
+	}
+
+	latestVersion, err := semver.ParseTolerant(latest)
+	if err != nil {
+		return SeverityUnknown
+	}
+
+	if currentVersion.GTE(latestVersion) {
+		return SeverityUpToDate
+	}
+
+	if currentVersion.Major != latestVersion.Major {
+		return SeverityMajorBehind
+	}
+
+	return SeverityMinorBehind
+}
+
+// splitExtensionRef parses an image ref such as "ghcr.io/siderolabs/foo:v1.2.3" into its bare
+// extension name and tag, ignoring the registry/repository prefix.
+func splitExtensionRef(ref string) (extName, tag string, err error) {
+	tagged, err := name.NewTag(ref)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse extension reference %s: %w", ref, err)
+	}
+
+	return extensionName(tagged), tagged.TagStr(), nil
+}
+
+// extensionName strips the registry/repository prefix off a tagged reference, leaving the bare
+// extension name used to match it across Talos versions.
+func extensionName(tagged name.Tag) string {
+	parts := strings.Split(tagged.RepositoryStr(), "/")
+
+	return parts[len(parts)-1]
+}
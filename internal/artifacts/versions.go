@@ -28,11 +28,40 @@ func (m *Manager) fetchTalosVersions() (any, error) {
 
 	repository := m.imageRegistry.Repo(ImagerImage)
 
+	// Cheap pre-check: the tags/list endpoint's ETag changes whenever the tag set does, so a
+	// conditional request lets us skip the full listing entirely when nothing changed, instead of
+	// paying for it and only skipping the reparse afterwards.
+	etag, etagErr := m.pullers[ArchAmd64].ListETag(ctx, repository)
+	if etagErr == nil && etag != "" {
+		if prev, ok := m.origin(talosVersionsOriginKey); ok && prev.ETag == etag {
+			m.logger.Info("Talos version listing unchanged, skipping relist", zap.String("etag", etag))
+
+			m.talosVersionsMu.Lock()
+			m.talosVersionsTimestamp = time.Now()
+			m.talosVersionsMu.Unlock()
+
+			m.recordOrigin(talosVersionsOriginKey, OriginMetadata{
+				Registry:  repository.RegistryStr(),
+				Ref:       repository.Name(),
+				Digest:    prev.Digest,
+				ETag:      etag,
+				FetchedAt: time.Now(),
+			})
+
+			return nil, nil //nolint:nilnil
+		}
+	}
+
 	candidates, err := m.pullers[ArchAmd64].List(ctx, repository)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list Talos versions: %w", err)
 	}
 
+	sorted := slices.Clone(candidates)
+	slices.Sort(sorted)
+
+	tagSetDigest := hashTagSet(sorted)
+
 	var versions []semver.Version //nolint:prealloc
 
 	for _, candidate := range candidates {
@@ -45,35 +74,85 @@ func (m *Manager) fetchTalosVersions() (any, error) {
 			continue // ignore versions below minimum
 		}
 
-		// filter out intermediate versions
-		if len(version.Pre) > 0 {
-			if len(version.Pre) != 2 {
-				continue
-			}
-
-			if !(version.Pre[0].VersionStr == "alpha" || version.Pre[0].VersionStr == "beta") {
-				continue
-			}
-
-			if !version.Pre[1].IsNumeric() {
+		if m.options.VersionFilter != nil {
+			if !m.options.VersionFilter(version) {
 				continue
 			}
+		} else if !versionAllowed(version, m.options.PrereleaseChannels, m.options.AllowBuildMetadata) {
+			continue
 		}
 
 		versions = append(versions, version)
 	}
 
 	slices.SortFunc(versions, func(a, b semver.Version) int {
-		return a.Compare(b)
+		if c := a.Compare(b); c != 0 {
+			return c
+		}
+
+		// Compare ignores build metadata in semver precedence, so fall back to it to keep two
+		// builds differing only in metadata from colliding in the sort.
+		return strings.Compare(strings.Join(a.Build, "."), strings.Join(b.Build, "."))
 	})
 
 	m.talosVersionsMu.Lock()
 	m.talosVersions, m.talosVersionsTimestamp = versions, time.Now()
 	m.talosVersionsMu.Unlock()
 
+	m.recordOrigin(talosVersionsOriginKey, OriginMetadata{
+		Registry:  repository.RegistryStr(),
+		Ref:       repository.Name(),
+		Digest:    tagSetDigest,
+		ETag:      etag,
+		FetchedAt: time.Now(),
+	})
+
 	return nil, nil //nolint:nilnil
 }
 
+// talosVersionsOriginKey identifies the Talos version listing in the origin metadata map.
+const talosVersionsOriginKey = "talos-versions"
+
+// defaultPrereleaseChannels is used when Manager.options.PrereleaseChannels is unset, preserving
+// the previous alpha/beta-only behavior.
+var defaultPrereleaseChannels = []string{"alpha", "beta"}
+
+// versionAllowed reports whether version passes the configured prerelease channel and build
+// metadata policy. A channel list containing "all" accepts any prerelease channel.
+func versionAllowed(version semver.Version, channels []string, allowBuildMetadata bool) bool {
+	if len(version.Build) > 0 && !allowBuildMetadata {
+		return false
+	}
+
+	if len(version.Pre) == 0 {
+		return true
+	}
+
+	// Only require a numeric prefix on the second identifier, not the whole identifier, so
+	// channel.N-style prereleases are recognized even with a trailing git-describe suffix (e.g.
+	// blang/semver parses "alpha.0-12-gabcdef" as Pre = [alpha, "0-12-gabcdef"]).
+	// A purely numeric identifier is stored in VersionNum with an empty VersionStr, hence IsNumeric.
+	if len(version.Pre) < 2 || !(version.Pre[1].IsNumeric() || numericPrefixLen(version.Pre[1].VersionStr) > 0) {
+		return false
+	}
+
+	if len(channels) == 0 {
+		channels = defaultPrereleaseChannels
+	}
+
+	return slices.Contains(channels, "all") || slices.Contains(channels, version.Pre[0].VersionStr)
+}
+
+// numericPrefixLen returns the length of the leading run of ASCII digits in s.
+func numericPrefixLen(s string) int {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+
+	return i
+}
+
 // ExtensionRef is a ref to the extension for some Talos version.
 type ExtensionRef struct {
 	TaggedReference name.Tag
@@ -81,22 +160,59 @@ type ExtensionRef struct {
 }
 
 func (m *Manager) fetchOfficialExtensions(tag string) error {
-	var extensions []ExtensionRef
+	ctx, cancel := context.WithTimeout(context.Background(), FetchTimeout)
+	defer cancel()
+
+	repository := m.imageRegistry.Repo(ExtensionManifestImage)
+
+	originKey := extensionsOriginKey(tag)
 
-	if err := m.fetchImageByTag(ExtensionManifestImage, tag, ArchAmd64, imageExportHandler(func(logger *zap.Logger, r io.Reader) error {
-		var extractErr error
+	digest, etag, headErr := m.pullers[ArchAmd64].Head(ctx, repository, tag)
+	if headErr == nil {
+		if prev, ok := m.origin(originKey); ok && prev.Digest == digest && prev.ETag == etag {
+			m.logger.Info("extension manifest unchanged, skipping re-pull", zap.String("tag", tag), zap.String("digest", digest))
 
-		extensions, extractErr = extractExtensionList(r)
+			m.recordOrigin(originKey, OriginMetadata{
+				Registry:  repository.RegistryStr(),
+				Ref:       repository.Name(),
+				Digest:    digest,
+				ETag:      etag,
+				Source:    prev.Source,
+				FetchedAt: time.Now(),
+			})
 
-		if extractErr == nil {
-			m.logger.Info("extracted the image digests", zap.Int("count", len(extensions)))
+			return nil
 		}
+	}
+
+	var (
+		extensions []ExtensionRef
+		usedSource string
+		err        error
+	)
+
+	for _, source := range m.extensionSources() {
+		extensions, err = source.fetch(ctx, m, tag)
+		if err == nil {
+			usedSource = source.name()
 
-		return extractErr
-	})); err != nil {
-		return err
+			break
+		}
+
+		if !errors.Is(err, errNoExtensions) {
+			return fmt.Errorf("failed to fetch official extensions via %s: %w", source.name(), err)
+		}
+
+		m.logger.Info("extension source produced nothing, trying next",
+			zap.String("source", source.name()), zap.String("tag", tag))
+	}
+
+	if usedSource == "" {
+		return fmt.Errorf("no extension source produced results for tag %s", tag)
 	}
 
+	m.logger.Info("fetched official extensions", zap.String("source", usedSource), zap.Int("count", len(extensions)))
+
 	m.officialExtensionsMu.Lock()
 
 	if m.officialExtensions == nil {
@@ -107,9 +223,23 @@ func (m *Manager) fetchOfficialExtensions(tag string) error {
 
 	m.officialExtensionsMu.Unlock()
 
+	m.recordOrigin(originKey, OriginMetadata{
+		Registry:  repository.RegistryStr(),
+		Ref:       repository.Name(),
+		Digest:    digest,
+		ETag:      etag,
+		Source:    usedSource,
+		FetchedAt: time.Now(),
+	})
+
 	return nil
 }
 
+// extensionsOriginKey identifies a tag's extension manifest in the origin metadata map.
+func extensionsOriginKey(tag string) string {
+	return "extensions:" + tag
+}
+
 func extractExtensionList(r io.Reader) ([]ExtensionRef, error) {
 	var extensions []ExtensionRef
 
@@ -157,5 +287,9 @@ func extractExtensionList(r io.Reader) ([]ExtensionRef, error) {
 		return extensions, nil
 	}
 
-	return nil, errors.New("failed to find image-digests file")
+	return nil, errImageDigestsNotFound
 }
+
+// errImageDigestsNotFound is returned when the manifest tar has no `image-digests` file, signaling
+// tarManifestSource to fall through to the next extensionSource.
+var errImageDigestsNotFound = errors.New("failed to find image-digests file")
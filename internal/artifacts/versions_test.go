@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package artifacts
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+)
+
+func TestVersionAllowed(t *testing.T) {
+	for _, tt := range []struct {
+		name               string
+		version            string
+		channels           []string
+		allowBuildMetadata bool
+		expected           bool
+	}{
+		{
+			name:     "rc rejected by default channels",
+			version:  "v1.7.0-rc.1",
+			expected: false,
+		},
+		{
+			name:     "rc accepted when channel is enabled",
+			version:  "v1.7.0-rc.1",
+			channels: []string{"alpha", "beta", "rc"},
+			expected: true,
+		},
+		{
+			name:     "alpha with git-describe suffix accepted by default channels",
+			version:  "v1.7.0-alpha.0-12-gabcdef",
+			expected: true,
+		},
+		{
+			name:     "build metadata rejected by default",
+			version:  "v1.7.0+dirty",
+			expected: false,
+		},
+		{
+			name:               "build metadata accepted when allowed",
+			version:            "v1.7.0+dirty",
+			allowBuildMetadata: true,
+			expected:           true,
+		},
+		{
+			name:     "all channel accepts any prerelease",
+			version:  "v1.7.0-rc.1",
+			channels: []string{"all"},
+			expected: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := semver.ParseTolerant(tt.version)
+			if err != nil {
+				t.Fatalf("failed to parse %s: %v", tt.version, err)
+			}
+
+			if got := versionAllowed(version, tt.channels, tt.allowBuildMetadata); got != tt.expected {
+				t.Errorf("versionAllowed(%s) = %v, want %v", tt.version, got, tt.expected)
+			}
+		})
+	}
+}